@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// GeneratorBackend is the project-generation engine selected by
+// Config.Backend. Implementations may shell out to external tools or
+// render a template entirely in-process; the rest of the program only
+// depends on this interface.
+type GeneratorBackend interface {
+	// Name is the backend's identifier, as used in Config.Backend and
+	// --backend.
+	Name() string
+
+	// Available reports whether the backend's prerequisites (e.g. a
+	// system python3) are present, before Generate is attempted.
+	Available(ctx context.Context) error
+
+	// Generate produces the project described by cfg, streaming human
+	// readable progress to out. Implementations that want to drive the
+	// TUI's segmented progress bar should call reportStage(out, name)
+	// between steps; out may or may not support it.
+	Generate(ctx context.Context, cfg Config, out io.Writer) error
+}
+
+// backendNames lists the valid Config.Backend / --backend values, in the
+// order they're offered in the TUI's stepBackend selection.
+var backendNames = []string{"cookiecutter", "copier", "embedded"}
+
+// backendFor resolves a backend name to its implementation. An empty name
+// selects the default (cookiecutter), matching the tool's original
+// behavior.
+func backendFor(name string) (GeneratorBackend, error) {
+	switch name {
+	case "", "cookiecutter":
+		return cookiecutterBackend{}, nil
+	case "copier":
+		return copierBackend{}, nil
+	case "embedded":
+		return embeddedBackend{}, nil
+	default:
+		return nil, fmt.Errorf("unknown backend %q (must be one of: %v)", name, backendNames)
+	}
+}
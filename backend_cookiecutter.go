@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"time"
+)
+
+// cookiecutterBackend is the original generation path: create a throwaway
+// venv, install cookiecutter into it, then run the cookiecutter template
+// from GitHub with all answers passed as --no-input arguments.
+type cookiecutterBackend struct{}
+
+func (cookiecutterBackend) Name() string { return "cookiecutter" }
+
+func (cookiecutterBackend) Available(ctx context.Context) error {
+	if err := exec.CommandContext(ctx, "python3", "--version").Run(); err != nil {
+		return fmt.Errorf("python3 not found: %w", err)
+	}
+	return nil
+}
+
+func (cookiecutterBackend) Generate(ctx context.Context, cfg Config, out io.Writer) error {
+	reportStage(out, "Creating virtual environment")
+	venvCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	defer cancel()
+	if err := runCommandStreamed(venvCtx, out, "", "python3", "-m", "venv", ".venv"); err != nil {
+		return fmt.Errorf("failed to create venv: %w", err)
+	}
+
+	reportStage(out, "Installing cookiecutter")
+	pipCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	if err := runCommandStreamed(pipCtx, out, "", ".venv/bin/pip", "install", "cookiecutter"); err != nil {
+		return fmt.Errorf("failed to install cookiecutter: %w", err)
+	}
+
+	reportStage(out, "Generating project from template")
+	genCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	if err := runCommandStreamed(genCtx, out, "", ".venv/bin/cookiecutter", cookiecutterArgs(cfg)...); err != nil {
+		return fmt.Errorf("failed to run cookiecutter: %w", err)
+	}
+
+	return nil
+}
+
+// cookiecutterArgs builds the cookiecutter invocation for cfg, templating
+// directly from github.com/thalestmm/fastapi-cookiecutter-template.
+func cookiecutterArgs(cfg Config) []string {
+	return []string{
+		"https://github.com/thalestmm/fastapi-cookiecutter-template.git",
+		"--no-input",
+		"--overwrite-if-exists",
+		"project_name=" + cfg.ProjectName,
+		"project_slug=" + projectSlugFor(cfg),
+		"project_description=" + cfg.ProjectDescription,
+		"author=" + cfg.Author,
+		"email=" + cfg.Email,
+		"python_version=" + cfg.PythonVersion,
+		"backend_port=" + cfg.BackendPort,
+		"use_postgres=" + yesNo(cfg.UsePostgres),
+		"use_supabase=" + yesNo(cfg.UseSupabase),
+		"ai_project=" + yesNo(cfg.AIProject),
+		"use_celery=" + yesNo(cfg.UseCelery),
+		"use_docker=" + yesNo(cfg.UseDocker),
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// copierTemplateURL is the copier-compatible mirror of the cookiecutter
+// template. Copier answers are passed with --data instead of cookiecutter's
+// bare key=value arguments.
+const copierTemplateURL = "https://github.com/thalestmm/fastapi-cookiecutter-template.git"
+
+// copierBackend generates (or updates) a project with Copier
+// (https://copier.readthedocs.io). Unlike cookiecutterBackend, Copier can
+// re-apply template changes to a project it previously generated, so
+// Generate runs "copier update" when the destination already exists.
+type copierBackend struct{}
+
+func (copierBackend) Name() string { return "copier" }
+
+func (copierBackend) Available(ctx context.Context) error {
+	if err := exec.CommandContext(ctx, "python3", "--version").Run(); err != nil {
+		return fmt.Errorf("python3 not found: %w", err)
+	}
+	return nil
+}
+
+func (copierBackend) Generate(ctx context.Context, cfg Config, out io.Writer) error {
+	reportStage(out, "Creating virtual environment")
+	venvCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	defer cancel()
+	if err := runCommandStreamed(venvCtx, out, "", "python3", "-m", "venv", ".venv"); err != nil {
+		return fmt.Errorf("failed to create venv: %w", err)
+	}
+
+	reportStage(out, "Installing copier")
+	pipCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	if err := runCommandStreamed(pipCtx, out, "", ".venv/bin/pip", "install", "copier"); err != nil {
+		return fmt.Errorf("failed to install copier: %w", err)
+	}
+
+	dest := projectSlugFor(cfg)
+	copyCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	if _, err := os.Stat(dest); err == nil {
+		reportStage(out, "Updating existing project with copier")
+		if err := runCommandStreamed(copyCtx, out, "", ".venv/bin/copier", "update", "--defaults", dest); err != nil {
+			return fmt.Errorf("failed to run copier update: %w", err)
+		}
+		return nil
+	}
+
+	reportStage(out, "Generating project with copier")
+	args := append([]string{"copy", "--defaults", copierTemplateURL, dest}, copierDataArgs(cfg, dest)...)
+	if err := runCommandStreamed(copyCtx, out, "", ".venv/bin/copier", args...); err != nil {
+		return fmt.Errorf("failed to run copier copy: %w", err)
+	}
+
+	return nil
+}
+
+// copierDataArgs builds the --data key=value pairs copier expects, one per
+// answer, mirroring cookiecutterArgs' bare key=value convention.
+func copierDataArgs(cfg Config, projectSlug string) []string {
+	data := map[string]string{
+		"project_name":        cfg.ProjectName,
+		"project_slug":        projectSlug,
+		"project_description": cfg.ProjectDescription,
+		"author":              cfg.Author,
+		"email":               cfg.Email,
+		"python_version":      cfg.PythonVersion,
+		"backend_port":        cfg.BackendPort,
+		"use_postgres":        yesNo(cfg.UsePostgres),
+		"use_supabase":        yesNo(cfg.UseSupabase),
+		"ai_project":          yesNo(cfg.AIProject),
+		"use_celery":          yesNo(cfg.UseCelery),
+		"use_docker":          yesNo(cfg.UseDocker),
+	}
+
+	// Fixed order keeps successive runs reproducible and easy to diff in
+	// logs, rather than relying on Go's randomized map iteration.
+	keys := []string{
+		"project_name", "project_slug", "project_description", "author", "email",
+		"python_version", "backend_port", "use_postgres", "use_supabase",
+		"ai_project", "use_celery", "use_docker",
+	}
+
+	args := make([]string, 0, len(keys)*2)
+	for _, k := range keys {
+		args = append(args, "--data", k+"="+data[k])
+	}
+	return args
+}
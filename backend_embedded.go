@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// embeddedTemplateFS ships the default project template inside the binary,
+// so the embedded backend needs neither system python3 nor network access.
+//
+//go:embed all:templates/embedded
+var embeddedTemplateFS embed.FS
+
+const embeddedTemplateRoot = "templates/embedded"
+
+// embeddedBackend renders embeddedTemplateFS with text/template directly
+// into the destination directory, entirely in-process.
+type embeddedBackend struct{}
+
+func (embeddedBackend) Name() string { return "embedded" }
+
+func (embeddedBackend) Available(ctx context.Context) error {
+	return nil
+}
+
+func (embeddedBackend) Generate(ctx context.Context, cfg Config, out io.Writer) error {
+	reportStage(out, "Rendering embedded template")
+
+	dest := projectSlugFor(cfg)
+	data := newEmbeddedTemplateData(cfg, dest)
+
+	return fs.WalkDir(embeddedTemplateFS, embeddedTemplateRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(embeddedTemplateRoot, path)
+		if err != nil {
+			return err
+		}
+
+		return renderEmbeddedFile(path, rel, dest, data, out)
+	})
+}
+
+// embeddedTemplateData is the set of fields available to templates under
+// templates/embedded, mirroring Config plus the derived project slug.
+type embeddedTemplateData struct {
+	ProjectName        string
+	ProjectSlug        string
+	ProjectDescription string
+	Author             string
+	Email              string
+	PythonVersion      string
+	BackendPort        string
+	UseDocker          bool
+	UsePostgres        bool
+	UseSupabase        bool
+	AIProject          bool
+	UseCelery          bool
+}
+
+func newEmbeddedTemplateData(cfg Config, projectSlug string) embeddedTemplateData {
+	return embeddedTemplateData{
+		ProjectName:        cfg.ProjectName,
+		ProjectSlug:        projectSlug,
+		ProjectDescription: cfg.ProjectDescription,
+		Author:             cfg.Author,
+		Email:              cfg.Email,
+		PythonVersion:      cfg.PythonVersion,
+		BackendPort:        cfg.BackendPort,
+		UseDocker:          cfg.UseDocker,
+		UsePostgres:        cfg.UsePostgres,
+		UseSupabase:        cfg.UseSupabase,
+		AIProject:          cfg.AIProject,
+		UseCelery:          cfg.UseCelery,
+	}
+}
+
+// renderEmbeddedFile renders the template at path (relative path rel under
+// the template root) into dest, skipping the ".tmpl" suffix. Files that
+// render to nothing (e.g. docker-compose.yml.tmpl when Docker isn't
+// selected) are skipped rather than written empty.
+func renderEmbeddedFile(path, rel, dest string, data embeddedTemplateData, out io.Writer) error {
+	raw, err := fs.ReadFile(embeddedTemplateFS, path)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New(rel).Parse(string(raw))
+	if err != nil {
+		return fmt.Errorf("parsing template %s: %w", rel, err)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return fmt.Errorf("rendering template %s: %w", rel, err)
+	}
+
+	if strings.TrimSpace(rendered.String()) == "" {
+		return nil
+	}
+
+	destPath := filepath.Join(dest, strings.TrimSuffix(rel, ".tmpl"))
+	if err := os.MkdirAll(filepath.Dir(destPath), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(destPath, rendered.Bytes(), 0o644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "created %s\n", destPath)
+	return nil
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+// cliOptions holds the parsed command-line flags for a non-interactive run.
+// Only fields explicitly passed on the command line are applied as
+// overrides; zero values are otherwise ignored so they don't clobber the
+// config file or env vars.
+type cliOptions struct {
+	nonInteractive bool
+	configPath     string
+	profile        string
+
+	projectName        string
+	projectDescription string
+	author             string
+	email              string
+	pythonVersion      string
+	backendPort        string
+	backend            string
+	useDocker          boolFlag
+	usePostgres        boolFlag
+	useSupabase        boolFlag
+	aiProject          boolFlag
+	useCelery          boolFlag
+	noGit              bool
+	noInstall          bool
+}
+
+// boolFlag tracks whether a bool flag was explicitly set, so "not passed"
+// can be distinguished from "passed as false".
+type boolFlag struct {
+	value bool
+	set   bool
+}
+
+func (b *boolFlag) String() string {
+	return fmt.Sprintf("%v", b.value)
+}
+
+func (b *boolFlag) Set(s string) error {
+	v, err := parseBoolFlag(s)
+	if err != nil {
+		return err
+	}
+	b.value = v
+	b.set = true
+	return nil
+}
+
+func (b *boolFlag) IsBoolFlag() bool { return true }
+
+func parseBoolFlag(s string) (bool, error) {
+	switch s {
+	case "", "true", "1":
+		return true, nil
+	case "false", "0":
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid boolean value %q", s)
+	}
+}
+
+// parseFlags parses the non-interactive CLI flags from args.
+func parseFlags(args []string) cliOptions {
+	fs := flag.NewFlagSet("fastapi-gen", flag.ExitOnError)
+
+	var opts cliOptions
+	fs.BoolVar(&opts.nonInteractive, "non-interactive", false, "run without the interactive TUI")
+	fs.StringVar(&opts.configPath, "config", "", "path to a YAML or JSON config file")
+	fs.StringVar(&opts.profile, "profile", "", "name of a saved answer profile to load (see ~/.config/fastapi-gen/profiles)")
+
+	fs.StringVar(&opts.projectName, "project-name", "", "project name")
+	fs.StringVar(&opts.projectDescription, "project-description", "", "project description")
+	fs.StringVar(&opts.author, "author", "", "author name")
+	fs.StringVar(&opts.email, "email", "", "author email")
+	fs.StringVar(&opts.pythonVersion, "python-version", "", "python version, e.g. 3.14")
+	fs.StringVar(&opts.backendPort, "backend-port", "", "backend port, e.g. 8000")
+	fs.StringVar(&opts.backend, "backend", "", "generator backend: cookiecutter, copier, or embedded")
+	fs.Var(&opts.useDocker, "use-docker", "include Docker support")
+	fs.Var(&opts.usePostgres, "use-postgres", "include PostgreSQL")
+	fs.Var(&opts.useSupabase, "use-supabase", "include Supabase")
+	fs.Var(&opts.aiProject, "ai-project", "include the LangGraph AI project")
+	fs.Var(&opts.useCelery, "use-celery", "include Celery workers")
+	fs.BoolVar(&opts.noGit, "no-git", false, "skip git init and the initial commit")
+	fs.BoolVar(&opts.noInstall, "no-install", false, "skip installing backend dependencies and the Docker build")
+
+	_ = fs.Parse(args)
+
+	return opts
+}
+
+// applyFlagOverrides overrides cfg fields with any flags explicitly passed
+// on the command line. Flags take precedence over the config file but are
+// themselves overridden by FASTAPI_GEN_* env vars.
+func applyFlagOverrides(cfg Config, opts cliOptions) Config {
+	if opts.projectName != "" {
+		cfg.ProjectName = opts.projectName
+	}
+	if opts.projectDescription != "" {
+		cfg.ProjectDescription = opts.projectDescription
+	}
+	if opts.author != "" {
+		cfg.Author = opts.author
+	}
+	if opts.email != "" {
+		cfg.Email = opts.email
+	}
+	if opts.pythonVersion != "" {
+		cfg.PythonVersion = opts.pythonVersion
+	}
+	if opts.backendPort != "" {
+		cfg.BackendPort = opts.backendPort
+	}
+	if opts.backend != "" {
+		cfg.Backend = opts.backend
+	}
+	if opts.useDocker.set {
+		cfg.UseDocker = opts.useDocker.value
+	}
+	if opts.usePostgres.set {
+		cfg.UsePostgres = opts.usePostgres.value
+	}
+	if opts.useSupabase.set {
+		cfg.UseSupabase = opts.useSupabase.value
+	}
+	if opts.aiProject.set {
+		cfg.AIProject = opts.aiProject.value
+	}
+	if opts.useCelery.set {
+		cfg.UseCelery = opts.useCelery.value
+	}
+	if opts.noGit {
+		cfg.GitInit = false
+	}
+	if opts.noInstall {
+		cfg.InstallDeps = false
+	}
+	return cfg
+}
+
+// runNonInteractive builds a Config from the config file, flags, and env
+// vars (in that precedence order) and drives executeSetup directly,
+// skipping the Bubble Tea program entirely.
+func runNonInteractive(opts cliOptions) error {
+	cfg := defaultConfig()
+
+	if opts.profile != "" {
+		profileCfg, warnings, err := loadProfile(opts.profile)
+		if err != nil {
+			return err
+		}
+		for _, w := range warnings {
+			fmt.Println("Warning:", w)
+		}
+		cfg = profileCfg
+	}
+
+	if opts.configPath != "" {
+		fileCfg, err := loadConfigFile(opts.configPath, cfg)
+		if err != nil {
+			return err
+		}
+		cfg = fileCfg
+	}
+
+	cfg = applyFlagOverrides(cfg, opts)
+	cfg = applyEnvOverrides(cfg)
+
+	if err := validateConfig(cfg); err != nil {
+		return err
+	}
+
+	if warning := checkPortAvailable(cfg.BackendPort); warning != "" {
+		fmt.Println("Warning:", warning)
+	}
+
+	fmt.Printf("Generating project %q (non-interactive)...\n", cfg.ProjectName)
+	output, hooks, err := runSetup(cfg)
+	if err != nil {
+		fmt.Println(output)
+		return err
+	}
+
+	if err := saveCompletedProfile(cfg); err != nil {
+		fmt.Println("Warning: failed to save profile:", err)
+	}
+
+	fmt.Printf("Project %q generated successfully.\n", cfg.ProjectName)
+	for _, hook := range hooks {
+		if hook.Err != nil {
+			fmt.Printf("  [failed] %s: %v\n", hook.Name, hook.Err)
+		} else {
+			fmt.Printf("  [ok] %s\n", hook.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config mirrors the fields collected interactively by model, so a config
+// file maps 1:1 onto the same data the TUI produces. It is the shape used
+// by --config, by FASTAPI_GEN_* env vars, and by executeSetup.
+type Config struct {
+	ProjectName        string `yaml:"projectName" json:"projectName"`
+	ProjectDescription string `yaml:"projectDescription" json:"projectDescription"`
+	Author             string `yaml:"author" json:"author"`
+	Email              string `yaml:"email" json:"email"`
+	PythonVersion      string `yaml:"pythonVersion" json:"pythonVersion"`
+	BackendPort        string `yaml:"backendPort" json:"backendPort"`
+	Backend            string `yaml:"backend" json:"backend"`
+	UseDocker          bool   `yaml:"useDocker" json:"useDocker"`
+	UsePostgres        bool   `yaml:"usePostgres" json:"usePostgres"`
+	UseSupabase        bool   `yaml:"useSupabase" json:"useSupabase"`
+	AIProject          bool   `yaml:"aiProject" json:"aiProject"`
+	UseCelery          bool   `yaml:"useCelery" json:"useCelery"`
+	GitInit            bool   `yaml:"gitInit" json:"gitInit"`
+	InstallDeps        bool   `yaml:"installDeps" json:"installDeps"`
+}
+
+// defaultConfig returns the same defaults initialModel uses for the TUI, so
+// non-interactive runs behave the same way unless overridden.
+func defaultConfig() Config {
+	return Config{
+		PythonVersion: "3.14",
+		BackendPort:   "8000",
+		Backend:       "cookiecutter",
+		UseDocker:     true,
+		UsePostgres:   true,
+		UseSupabase:   true,
+		AIProject:     true,
+		UseCelery:     true,
+		GitInit:       true,
+		InstallDeps:   true,
+	}
+}
+
+// configFromModel converts a completed model into a Config so interactive
+// and non-interactive runs can share the same generation pipeline.
+func configFromModel(m model) Config {
+	return Config{
+		ProjectName:        m.projectName,
+		ProjectDescription: m.projectDescription,
+		Author:             m.author,
+		Email:              m.email,
+		PythonVersion:      m.pythonVersion,
+		BackendPort:        m.backendPort,
+		Backend:            m.backend,
+		UseDocker:          m.useDocker,
+		UsePostgres:        m.usePostgres,
+		UseSupabase:        m.useSupabase,
+		AIProject:          m.aiProject,
+		UseCelery:          m.useCelery,
+		GitInit:            m.gitInit,
+		InstallDeps:        m.installDeps,
+	}
+}
+
+// applyConfigToModel copies cfg's fields into m, the inverse of
+// configFromModel. It's used to pre-populate the TUI from a loaded profile.
+func applyConfigToModel(m *model, cfg Config) {
+	m.projectName = cfg.ProjectName
+	m.projectDescription = cfg.ProjectDescription
+	m.author = cfg.Author
+	m.email = cfg.Email
+	m.pythonVersion = cfg.PythonVersion
+	m.backendPort = cfg.BackendPort
+	m.backend = cfg.Backend
+	m.useDocker = cfg.UseDocker
+	m.usePostgres = cfg.UsePostgres
+	m.useSupabase = cfg.UseSupabase
+	m.aiProject = cfg.AIProject
+	m.useCelery = cfg.UseCelery
+	m.gitInit = cfg.GitInit
+	m.installDeps = cfg.InstallDeps
+}
+
+// projectSlugFor derives the cookiecutter project_slug from a project name,
+// the same way both the TUI and the streaming/non-interactive pipelines do.
+func projectSlugFor(cfg Config) string {
+	return strings.ToLower(strings.ReplaceAll(cfg.ProjectName, " ", "-"))
+}
+
+// loadConfigFile reads a YAML or JSON config file, chosen by extension
+// (.json vs anything else, defaulting to YAML), unmarshalling onto base so
+// any fields the file doesn't set fall through to whatever the caller
+// already had (e.g. a profile loaded ahead of it) rather than defaultConfig.
+func loadConfigFile(path string, base Config) (Config, error) {
+	cfg := base
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	if strings.EqualFold(filepathExt(path), ".json") {
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return cfg, fmt.Errorf("failed to parse JSON config: %w", err)
+		}
+		return cfg, nil
+	}
+
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse YAML config: %w", err)
+	}
+	return cfg, nil
+}
+
+func filepathExt(path string) string {
+	if i := strings.LastIndex(path, "."); i != -1 {
+		return path[i:]
+	}
+	return ""
+}
+
+// applyEnvOverrides overrides cfg fields from FASTAPI_GEN_* environment
+// variables. Env vars take precedence over both the config file and flags.
+func applyEnvOverrides(cfg Config) Config {
+	if v, ok := os.LookupEnv("FASTAPI_GEN_PROJECT_NAME"); ok {
+		cfg.ProjectName = v
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_PROJECT_DESCRIPTION"); ok {
+		cfg.ProjectDescription = v
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_AUTHOR"); ok {
+		cfg.Author = v
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_EMAIL"); ok {
+		cfg.Email = v
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_PYTHON_VERSION"); ok {
+		cfg.PythonVersion = v
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_BACKEND_PORT"); ok {
+		cfg.BackendPort = v
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_BACKEND"); ok {
+		cfg.Backend = v
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_USE_DOCKER"); ok {
+		cfg.UseDocker = parseBoolEnv(v, cfg.UseDocker)
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_USE_POSTGRES"); ok {
+		cfg.UsePostgres = parseBoolEnv(v, cfg.UsePostgres)
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_USE_SUPABASE"); ok {
+		cfg.UseSupabase = parseBoolEnv(v, cfg.UseSupabase)
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_AI_PROJECT"); ok {
+		cfg.AIProject = parseBoolEnv(v, cfg.AIProject)
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_USE_CELERY"); ok {
+		cfg.UseCelery = parseBoolEnv(v, cfg.UseCelery)
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_GIT_INIT"); ok {
+		cfg.GitInit = parseBoolEnv(v, cfg.GitInit)
+	}
+	if v, ok := os.LookupEnv("FASTAPI_GEN_INSTALL_DEPS"); ok {
+		cfg.InstallDeps = parseBoolEnv(v, cfg.InstallDeps)
+	}
+	return cfg
+}
+
+func parseBoolEnv(v string, fallback bool) bool {
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return fallback
+	}
+	return b
+}
+
+// validateConfig checks the minimum required fields for a non-interactive
+// run are present before we ever shell out to cookiecutter.
+func validateConfig(cfg Config) error {
+	if cfg.ProjectName == "" {
+		return fmt.Errorf("project name is required (--project-name, FASTAPI_GEN_PROJECT_NAME, or config file)")
+	}
+	if cfg.Author == "" {
+		return fmt.Errorf("author is required (--author, FASTAPI_GEN_AUTHOR, or config file)")
+	}
+	if cfg.Email == "" {
+		return fmt.Errorf("email is required (--email, FASTAPI_GEN_EMAIL, or config file)")
+	}
+	if cfg.PythonVersion == "" {
+		return fmt.Errorf("python version is required")
+	}
+	if cfg.BackendPort == "" {
+		return fmt.Errorf("backend port is required")
+	}
+	if err := validateStepInput(stepProjectName, cfg.ProjectName); err != nil {
+		return err
+	}
+	if err := validateStepInput(stepEmail, cfg.Email); err != nil {
+		return err
+	}
+	if err := validateStepInput(stepPythonVersion, cfg.PythonVersion); err != nil {
+		return err
+	}
+	if err := validateStepInput(stepBackendPort, cfg.BackendPort); err != nil {
+		return err
+	}
+	if _, err := backendFor(cfg.Backend); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestApplyFlagOverrides(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.ProjectName = "from-config-file"
+	cfg.BackendPort = "8000"
+
+	opts := cliOptions{
+		projectName: "from-flag",
+		useDocker:   boolFlag{value: false, set: true},
+		noGit:       true,
+	}
+
+	got := applyFlagOverrides(cfg, opts)
+
+	if got.ProjectName != "from-flag" {
+		t.Errorf("ProjectName = %q, want %q (flag should override)", got.ProjectName, "from-flag")
+	}
+	if got.BackendPort != "8000" {
+		t.Errorf("BackendPort = %q, want %q (unset flag should fall through)", got.BackendPort, "8000")
+	}
+	if got.UseDocker {
+		t.Errorf("UseDocker = true, want false (explicit boolFlag should override)")
+	}
+	if got.GitInit {
+		t.Errorf("GitInit = true, want false (--no-git should override)")
+	}
+}
+
+func TestApplyFlagOverrides_UnsetBoolFlagDoesNotClobber(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.UsePostgres = false
+
+	got := applyFlagOverrides(cfg, cliOptions{})
+
+	if got.UsePostgres {
+		t.Errorf("UsePostgres = true, want false (unset boolFlag must not override)")
+	}
+}
+
+func TestApplyEnvOverrides(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.ProjectName = "from-flag"
+
+	t.Setenv("FASTAPI_GEN_PROJECT_NAME", "from-env")
+	t.Setenv("FASTAPI_GEN_USE_CELERY", "false")
+
+	got := applyEnvOverrides(cfg)
+
+	if got.ProjectName != "from-env" {
+		t.Errorf("ProjectName = %q, want %q (env should override flags)", got.ProjectName, "from-env")
+	}
+	if got.UseCelery {
+		t.Errorf("UseCelery = true, want false (FASTAPI_GEN_USE_CELERY=false should override)")
+	}
+}
+
+func TestLoadConfigFile_MergesOntoBaseInsteadOfReplacing(t *testing.T) {
+	base := Config{
+		ProjectName:   "from-profile",
+		Author:        "Profile Author",
+		Email:         "profile@example.com",
+		PythonVersion: "3.12",
+		BackendPort:   "8000",
+		Backend:       "embedded",
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "partial.yaml")
+	contents := "projectName: \"from-config-file\"\nbackendPort: \"9999\"\n"
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := loadConfigFile(path, base)
+	if err != nil {
+		t.Fatalf("loadConfigFile() error = %v", err)
+	}
+
+	if got.ProjectName != "from-config-file" {
+		t.Errorf("ProjectName = %q, want %q (config file should override)", got.ProjectName, "from-config-file")
+	}
+	if got.BackendPort != "9999" {
+		t.Errorf("BackendPort = %q, want %q (config file should override)", got.BackendPort, "9999")
+	}
+	if got.Author != base.Author {
+		t.Errorf("Author = %q, want %q (unset-in-file fields should fall through from base)", got.Author, base.Author)
+	}
+	if got.Email != base.Email {
+		t.Errorf("Email = %q, want %q (unset-in-file fields should fall through from base)", got.Email, base.Email)
+	}
+	if got.Backend != base.Backend {
+		t.Errorf("Backend = %q, want %q (unset-in-file fields should fall through from base)", got.Backend, base.Backend)
+	}
+}
+
+// TestPrecedenceChain exercises the full profile < config file < flags < env
+// layering runNonInteractive builds: each layer should override only the
+// fields it explicitly sets, leaving the rest to fall through.
+func TestPrecedenceChain(t *testing.T) {
+	profileCfg := Config{
+		ProjectName:   "profile-name",
+		Author:        "Profile Author",
+		Email:         "profile@example.com",
+		PythonVersion: "3.12",
+		BackendPort:   "8000",
+		Backend:       "embedded",
+		UseDocker:     true,
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(path, []byte("backendPort: \"9000\"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := loadConfigFile(path, profileCfg)
+	if err != nil {
+		t.Fatalf("loadConfigFile() error = %v", err)
+	}
+
+	cfg = applyFlagOverrides(cfg, cliOptions{author: "Flag Author"})
+
+	t.Setenv("FASTAPI_GEN_EMAIL", "env@example.com")
+	cfg = applyEnvOverrides(cfg)
+
+	switch {
+	case cfg.ProjectName != "profile-name":
+		t.Errorf("ProjectName = %q, want %q (from profile, untouched by later layers)", cfg.ProjectName, "profile-name")
+	case cfg.BackendPort != "9000":
+		t.Errorf("BackendPort = %q, want %q (from config file)", cfg.BackendPort, "9000")
+	case cfg.Author != "Flag Author":
+		t.Errorf("Author = %q, want %q (from flag)", cfg.Author, "Flag Author")
+	case cfg.Email != "env@example.com":
+		t.Errorf("Email = %q, want %q (from env, overriding profile)", cfg.Email, "env@example.com")
+	case cfg.Backend != "embedded":
+		t.Errorf("Backend = %q, want %q (from profile, untouched by later layers)", cfg.Backend, "embedded")
+	}
+}
@@ -1,12 +1,15 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -41,21 +44,33 @@ var (
 			Foreground(lipgloss.Color("#FF0000")).
 			Bold(true)
 
+	warningStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#F4BF4F")).
+			Bold(true)
+
 	successStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#00D787")).
 			Bold(true)
+
+	logBoxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("#666666")).
+			Padding(0, 1)
 )
 
 type step int
 
 const (
-	stepProjectName step = iota
+	stepProfileLoad step = iota
+	stepProfileWarning
+	stepProjectName
 	stepProjectDescription
 	stepAuthor
 	stepEmail
 	stepPythonVersion
 	stepBackendPort
 	stepFeatures
+	stepBackend
 	stepConfirm
 	stepExecuting
 	stepDone
@@ -66,9 +81,27 @@ type model struct {
 	textInput       textinput.Model
 	cursor          int
 	err             error
+	portWarning     string
 	executing       bool
 	executionOutput string
 	executionError  error
+	hookResults     []hookResult
+
+	// Live execution state (stepExecuting)
+	spinner             spinner.Model
+	logViewport         viewport.Model
+	logLines            []string
+	stageOrder          []string
+	currentStage        string
+	currentStageStarted bool
+	stagesDone          map[string]bool
+	execSub             chan tea.Msg
+	execCancel          context.CancelFunc
+	viewportReady       bool
+
+	// Profile selection (stepProfileLoad, stepProfileWarning)
+	profileChoices  []string
+	profileWarnings []string
 
 	// Collected data
 	projectName        string
@@ -77,36 +110,54 @@ type model struct {
 	email              string
 	pythonVersion      string
 	backendPort        string
+	backend            string
 	useDocker          bool
 	usePostgres        bool
 	useSupabase        bool
 	aiProject          bool
 	useCelery          bool
+	gitInit            bool
+	installDeps        bool
 }
 
-type executionCompleteMsg struct {
-	output string
-	err    error
-}
-
-func initialModel() model {
+// initialModel builds the starting model for the interactive TUI. If
+// profile is non-empty (from --profile), it's auto-loaded in place of
+// showing the "Load a Saved Profile?" screen, the same way selecting it
+// from that screen would behave.
+func initialModel(profile string) model {
 	ti := textinput.New()
 	ti.Placeholder = "My Awesome Project"
 	ti.Focus()
 	ti.CharLimit = 156
 	ti.Width = 50
 
-	return model{
-		step:          stepProjectName,
-		textInput:     ti,
-		pythonVersion: "3.14",
-		backendPort:   "8000",
-		useDocker:     true,
-		usePostgres:   true,
-		useSupabase:   true,
-		aiProject:     true,
-		useCelery:     true,
+	sp := spinner.New()
+	sp.Spinner = spinner.Dot
+	sp.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("#7D56F4"))
+
+	m := model{
+		step:           stepProfileLoad,
+		textInput:      ti,
+		spinner:        sp,
+		stagesDone:     map[string]bool{},
+		profileChoices: buildProfileChoices(),
+		pythonVersion:  "3.14",
+		backendPort:    "8000",
+		backend:        "cookiecutter",
+		useDocker:      true,
+		usePostgres:    true,
+		useSupabase:    true,
+		aiProject:      true,
+		useCelery:      true,
+		gitInit:        true,
+		installDeps:    true,
 	}
+
+	if profile != "" {
+		m.applyProfileChoice(profile)
+	}
+
+	return m
 }
 
 func (m model) Init() tea.Cmd {
@@ -120,7 +171,10 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c", "q":
-			if m.step == stepDone || m.step == stepExecuting {
+			if m.step == stepExecuting {
+				if m.execCancel != nil {
+					m.execCancel()
+				}
 				return m, tea.Quit
 			}
 			return m, tea.Quit
@@ -131,36 +185,81 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "up", "k":
 			if m.step == stepFeatures && m.cursor > 0 {
 				m.cursor--
+			} else if m.step == stepBackend && m.cursor > 0 {
+				m.cursor--
+			} else if m.step == stepProfileLoad && m.cursor > 0 {
+				m.cursor--
 			}
 
 		case "down", "j":
-			if m.step == stepFeatures && m.cursor < 4 {
+			if m.step == stepFeatures && m.cursor < 6 {
+				m.cursor++
+			} else if m.step == stepBackend && m.cursor < len(backendNames)-1 {
+				m.cursor++
+			} else if m.step == stepProfileLoad && m.cursor < len(m.profileChoices)-1 {
 				m.cursor++
 			}
 
 		case " ", "space":
 			if m.step == stepFeatures {
 				m.toggleFeature()
-			} else if m.step == stepConfirm {
+			} else if m.step == stepConfirm || m.step == stepProfileWarning {
 				m.cursor = (m.cursor + 1) % 2
 			}
 
 		case "tab":
-			if m.step == stepConfirm {
+			if m.step == stepConfirm || m.step == stepProfileWarning {
 				m.cursor = (m.cursor + 1) % 2
 			}
 		}
 
-	case executionCompleteMsg:
+	case tea.WindowSizeMsg:
+		if !m.viewportReady {
+			m.logViewport = viewport.New(msg.Width-4, 10)
+			m.viewportReady = true
+		} else {
+			m.logViewport.Width = msg.Width - 4
+		}
+
+	case logLineMsg:
+		m.logLines = append(m.logLines, msg.line)
+		m.logViewport.SetContent(strings.Join(m.logLines, "\n"))
+		m.logViewport.GotoBottom()
+		return m, listenForExecMsg(m.execSub)
+
+	case stageChangedMsg:
+		if m.currentStageStarted {
+			m.stagesDone[m.currentStage] = true
+		}
+		m.currentStage = msg.name
+		m.currentStageStarted = true
+		m.stageOrder = append(m.stageOrder, msg.name)
+		return m, listenForExecMsg(m.execSub)
+
+	case execDoneMsg:
 		m.executing = false
-		m.executionOutput = msg.output
+		m.executionOutput = strings.Join(m.logLines, "\n")
 		m.executionError = msg.err
+		m.hookResults = msg.hooks
+		if msg.err == nil {
+			m.stagesDone[m.currentStage] = true
+			_ = saveCompletedProfile(configFromModel(m))
+		}
 		m.step = stepDone
 		return m, nil
+
+	case spinner.TickMsg:
+		if m.step == stepExecuting {
+			m.spinner, cmd = m.spinner.Update(msg)
+			return m, cmd
+		}
 	}
 
 	// Update text input
 	if m.isTextInputStep() {
+		if _, ok := msg.(tea.KeyMsg); ok {
+			m.err = nil
+		}
 		m.textInput, cmd = m.textInput.Update(msg)
 	}
 
@@ -169,54 +268,122 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 func (m *model) handleEnter() (tea.Model, tea.Cmd) {
 	switch m.step {
+	case stepProfileLoad:
+		choice := m.profileChoices[m.cursor]
+		if choice == startFreshChoice {
+			m.step = stepProjectName
+			return m, nil
+		}
+		m.applyProfileChoice(choice)
+
+	case stepProfileWarning:
+		if m.cursor == 0 { // Continue
+			m.step = stepProjectName
+		} else { // Go back
+			m.step = stepProfileLoad
+			m.cursor = 0
+		}
+
 	case stepProjectName:
-		if m.textInput.Value() != "" {
-			m.projectName = m.textInput.Value()
-			m.step = stepProjectDescription
-			m.textInput.SetValue("")
-			m.textInput.Placeholder = "A modern FastAPI application"
+		value := m.textInput.Value()
+		if value == "" {
+			break
 		}
+		if err := validateStepInput(m.step, value); err != nil {
+			m.err = err
+			break
+		}
+		m.err = nil
+		m.projectName = value
+		m.step = stepProjectDescription
+		m.textInput.SetValue(m.projectDescription)
+		m.textInput.Placeholder = "A modern FastAPI application"
 
 	case stepProjectDescription:
-		if m.textInput.Value() != "" {
-			m.projectDescription = m.textInput.Value()
-			m.step = stepAuthor
-			m.textInput.SetValue("")
-			m.textInput.Placeholder = "Your Name"
+		value := m.textInput.Value()
+		if value == "" {
+			break
+		}
+		if err := validateStepInput(m.step, value); err != nil {
+			m.err = err
+			break
 		}
+		m.err = nil
+		m.projectDescription = value
+		m.step = stepAuthor
+		m.textInput.SetValue(m.author)
+		m.textInput.Placeholder = "Your Name"
 
 	case stepAuthor:
-		if m.textInput.Value() != "" {
-			m.author = m.textInput.Value()
-			m.step = stepEmail
-			m.textInput.SetValue("")
-			m.textInput.Placeholder = "your.email@example.com"
+		value := m.textInput.Value()
+		if value == "" {
+			break
 		}
+		if err := validateStepInput(m.step, value); err != nil {
+			m.err = err
+			break
+		}
+		m.err = nil
+		m.author = value
+		m.step = stepEmail
+		m.textInput.SetValue(m.email)
+		m.textInput.Placeholder = "your.email@example.com"
 
 	case stepEmail:
-		if m.textInput.Value() != "" {
-			m.email = m.textInput.Value()
-			m.step = stepPythonVersion
-			m.textInput.SetValue(m.pythonVersion)
-			m.textInput.Placeholder = "3.14"
+		value := m.textInput.Value()
+		if value == "" {
+			break
+		}
+		if err := validateStepInput(m.step, value); err != nil {
+			m.err = err
+			break
 		}
+		m.err = nil
+		m.email = value
+		m.step = stepPythonVersion
+		m.textInput.SetValue(m.pythonVersion)
+		m.textInput.Placeholder = "3.14"
 
 	case stepPythonVersion:
-		if m.textInput.Value() != "" {
-			m.pythonVersion = m.textInput.Value()
-			m.step = stepBackendPort
-			m.textInput.SetValue(m.backendPort)
-			m.textInput.Placeholder = "8000"
+		value := m.textInput.Value()
+		if value == "" {
+			break
+		}
+		if err := validateStepInput(m.step, value); err != nil {
+			m.err = err
+			break
 		}
+		m.err = nil
+		m.pythonVersion = value
+		m.step = stepBackendPort
+		m.textInput.SetValue(m.backendPort)
+		m.textInput.Placeholder = "8000"
 
 	case stepBackendPort:
-		if m.textInput.Value() != "" {
-			m.backendPort = m.textInput.Value()
-			m.step = stepFeatures
-			m.cursor = 0
+		value := m.textInput.Value()
+		if value == "" {
+			break
+		}
+		if err := validateStepInput(m.step, value); err != nil {
+			m.err = err
+			break
 		}
+		m.err = nil
+		m.backendPort = value
+		m.portWarning = checkPortAvailable(value)
+		m.step = stepFeatures
+		m.cursor = 0
 
 	case stepFeatures:
+		m.step = stepBackend
+		for i, name := range backendNames {
+			if name == m.backend {
+				m.cursor = i
+			}
+		}
+
+	case stepBackend:
+		m.backend = backendNames[m.cursor]
 		m.step = stepConfirm
 		m.cursor = 0
 
@@ -224,10 +391,15 @@ func (m *model) handleEnter() (tea.Model, tea.Cmd) {
 		if m.cursor == 0 { // Confirm
 			m.step = stepExecuting
 			m.executing = true
-			return m, m.executeSetup()
+			m.execSub, m.execCancel = startStreamingSetup(configFromModel(*m))
+			return m, tea.Batch(m.spinner.Tick, listenForExecMsg(m.execSub))
 		} else { // Go back
-			m.step = stepFeatures
-			m.cursor = 0
+			m.step = stepBackend
+			for i, name := range backendNames {
+				if name == m.backend {
+					m.cursor = i
+				}
+			}
 		}
 
 	case stepDone:
@@ -237,6 +409,29 @@ func (m *model) handleEnter() (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// applyProfileChoice loads the named profile into m and decides which step
+// to land on: stepProfileWarning if it produced warnings, stepProjectName
+// otherwise. A load failure falls back to stepProjectName with m.err set,
+// the same as starting fresh.
+func (m *model) applyProfileChoice(name string) {
+	cfg, warnings, err := loadProfile(name)
+	if err != nil {
+		m.err = err
+		m.step = stepProjectName
+		return
+	}
+	applyConfigToModel(m, cfg)
+	m.textInput.SetValue(m.projectName)
+
+	if len(warnings) > 0 {
+		m.profileWarnings = warnings
+		m.cursor = 0
+		m.step = stepProfileWarning
+	} else {
+		m.step = stepProjectName
+	}
+}
+
 func (m *model) toggleFeature() {
 	switch m.cursor {
 	case 0:
@@ -249,6 +444,10 @@ func (m *model) toggleFeature() {
 		m.aiProject = !m.aiProject
 	case 4:
 		m.useCelery = !m.useCelery
+	case 5:
+		m.gitInit = !m.gitInit
+	case 6:
+		m.installDeps = !m.installDeps
 	}
 }
 
@@ -257,6 +456,14 @@ func (m model) isTextInputStep() bool {
 }
 
 func (m model) View() string {
+	if m.step == stepProfileLoad {
+		return m.renderProfileLoad()
+	}
+
+	if m.step == stepProfileWarning {
+		return m.renderProfileWarning()
+	}
+
 	if m.step == stepExecuting {
 		return m.renderExecuting()
 	}
@@ -274,7 +481,7 @@ func (m model) View() string {
 	s.WriteString("\n\n")
 
 	// Progress indicator
-	progress := fmt.Sprintf("Step %d of 8", int(m.step)+1)
+	progress := fmt.Sprintf("Step %d of %d", int(m.step-stepProjectName)+1, int(stepConfirm-stepProjectName)+1)
 	s.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render(progress))
 	s.WriteString("\n\n")
 
@@ -294,6 +501,8 @@ func (m model) View() string {
 		s.WriteString(m.renderTextInput("Backend Port", "Which port should the backend run on?"))
 	case stepFeatures:
 		s.WriteString(m.renderFeatures())
+	case stepBackend:
+		s.WriteString(m.renderBackendSelect())
 	case stepConfirm:
 		s.WriteString(m.renderConfirmation())
 	}
@@ -308,7 +517,12 @@ func (m model) renderTextInput(label, subtitle string) string {
 	s.WriteString(subtitleStyle.Render(subtitle))
 	s.WriteString("\n\n")
 	s.WriteString(m.textInput.View())
-	s.WriteString("\n\n")
+	s.WriteString("\n")
+	if m.err != nil {
+		s.WriteString(errorStyle.Render(m.err.Error()))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
 	s.WriteString(helpStyle.Render("Press Enter to continue â€¢ Ctrl+C to quit"))
 	return s.String()
 }
@@ -330,6 +544,8 @@ func (m model) renderFeatures() string {
 		{"Supabase", "Backend-as-a-Service integration", m.useSupabase},
 		{"AI Project", "LangGraph agent with tool calling", m.aiProject},
 		{"Celery Workers", "Distributed task queue for background jobs", m.useCelery},
+		{"Git Init & Commit", "git init, add, and an initial commit on main", m.gitInit},
+		{"Install Dependencies", "uv sync / pip install, plus docker compose build if Docker is selected", m.installDeps},
 	}
 
 	for i, feature := range features {
@@ -358,6 +574,110 @@ func (m model) renderFeatures() string {
 	return s.String()
 }
 
+func (m model) renderProfileLoad() string {
+	var s strings.Builder
+	s.WriteString(titleStyle.Render("FastAPI Project Generator"))
+	s.WriteString("\n")
+	s.WriteString(focusedStyle.Render("Load a Saved Profile?"))
+	s.WriteString("\n")
+	s.WriteString(subtitleStyle.Render("Pick a profile to pre-fill your answers, or start fresh"))
+	s.WriteString("\n\n")
+
+	for i, choice := range m.profileChoices {
+		label := choice
+		if choice == lastUsedProfileName {
+			label = "last-used (most recent answers)"
+		}
+
+		cursor := "  "
+		if m.cursor == i {
+			cursor = cursorStyle.Render("â–¸ ")
+		}
+
+		line := fmt.Sprintf("%s%s", cursor, label)
+		if m.cursor == i {
+			line = focusedStyle.Render(line)
+		}
+		s.WriteString(line)
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(helpStyle.Render("â†‘/â†“: Navigate â€¢ Enter: Select â€¢ Ctrl+C: Quit"))
+	return s.String()
+}
+
+func (m model) renderProfileWarning() string {
+	var s strings.Builder
+	s.WriteString(errorStyle.Render("Profile Warnings"))
+	s.WriteString("\n")
+	s.WriteString(subtitleStyle.Render("The selected profile raised the following, review before continuing"))
+	s.WriteString("\n\n")
+
+	for _, warning := range m.profileWarnings {
+		s.WriteString(fmt.Sprintf("- %s\n", warning))
+	}
+	s.WriteString("\n")
+
+	continueBtn := " Continue "
+	backBtn := " Go Back "
+
+	if m.cursor == 0 {
+		continueBtn = selectedStyle.Render("â–¸ " + continueBtn)
+		backBtn = "  " + backBtn
+	} else {
+		continueBtn = "  " + continueBtn
+		backBtn = selectedStyle.Render("â–¸ " + backBtn)
+	}
+
+	s.WriteString(continueBtn)
+	s.WriteString("  ")
+	s.WriteString(backBtn)
+	s.WriteString("\n\n")
+	s.WriteString(helpStyle.Render("Tab/Space: Switch â€¢ Enter: Select â€¢ Ctrl+C: Quit"))
+
+	return s.String()
+}
+
+func (m model) renderBackendSelect() string {
+	var s strings.Builder
+	s.WriteString(focusedStyle.Render("Select Generator Backend"))
+	s.WriteString("\n")
+	s.WriteString(subtitleStyle.Render("Choose which engine generates your project"))
+	s.WriteString("\n\n")
+
+	descriptions := map[string]string{
+		"cookiecutter": "Default: venv + pip install cookiecutter, templated from GitHub",
+		"copier":       "Like cookiecutter, but supports re-applying template updates later",
+		"embedded":     "No python3 required: renders a template embedded in this binary",
+	}
+
+	for i, name := range backendNames {
+		cursor := "  "
+		if m.cursor == i {
+			cursor = cursorStyle.Render("â–¸ ")
+		}
+
+		radio := "( )"
+		if name == backendNames[m.cursor] {
+			radio = selectedStyle.Render("(â€¢)")
+		}
+
+		line := fmt.Sprintf("%s%s %s", cursor, radio, name)
+		if m.cursor == i {
+			line = focusedStyle.Render(line)
+		}
+		s.WriteString(line)
+		s.WriteString("\n")
+		s.WriteString("    " + lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Render(descriptions[name]))
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(helpStyle.Render("â†‘/â†“: Navigate â€¢ Enter: Select â€¢ Ctrl+C: Quit"))
+	return s.String()
+}
+
 func (m model) renderConfirmation() string {
 	var s strings.Builder
 	s.WriteString(focusedStyle.Render("ðŸ“‹ Confirm Configuration"))
@@ -365,7 +685,7 @@ func (m model) renderConfirmation() string {
 	s.WriteString(subtitleStyle.Render("Please review your project configuration"))
 	s.WriteString("\n\n")
 
-	projectSlug := strings.ToLower(strings.ReplaceAll(m.projectName, " ", "-"))
+	projectSlug := projectSlugFor(configFromModel(m))
 
 	configs := []struct{ key, value string }{
 		{"Project Name", m.projectName},
@@ -375,11 +695,14 @@ func (m model) renderConfirmation() string {
 		{"Email", m.email},
 		{"Python Version", m.pythonVersion},
 		{"Backend Port", m.backendPort},
+		{"Generator", m.backend},
 		{"Docker", yesNo(m.useDocker)},
 		{"PostgreSQL", yesNo(m.usePostgres)},
 		{"Supabase", yesNo(m.useSupabase)},
 		{"AI Project", yesNo(m.aiProject)},
 		{"Celery", yesNo(m.useCelery)},
+		{"Git Init & Commit", yesNo(m.gitInit)},
+		{"Install Dependencies", yesNo(m.installDeps)},
 	}
 
 	for _, cfg := range configs {
@@ -389,6 +712,11 @@ func (m model) renderConfirmation() string {
 
 	s.WriteString("\n")
 
+	if m.portWarning != "" {
+		s.WriteString(warningStyle.Render("Warning: " + m.portWarning))
+		s.WriteString("\n\n")
+	}
+
 	// Buttons
 	confirmBtn := " Confirm & Generate "
 	backBtn := " Go Back "
@@ -414,15 +742,39 @@ func (m model) renderExecuting() string {
 	var s strings.Builder
 	s.WriteString(titleStyle.Render("ðŸ”¨ Generating Your Project..."))
 	s.WriteString("\n\n")
-	s.WriteString("This may take a minute while we:\n\n")
-	s.WriteString("  â€¢ Create Python virtual environment\n")
-	s.WriteString("  â€¢ Install cookiecutter\n")
-	s.WriteString("  â€¢ Generate project from template\n")
-	s.WriteString("\n")
-	s.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#7D56F4")).Render("â ‹ Please wait..."))
+	s.WriteString(m.renderStageProgress())
+	s.WriteString("\n\n")
+	s.WriteString(fmt.Sprintf("%s %s\n\n", m.spinner.View(), m.currentStage))
+
+	if m.viewportReady {
+		s.WriteString(logBoxStyle.Render(m.logViewport.View()))
+		s.WriteString("\n")
+	}
+
+	s.WriteString(helpStyle.Render("Ctrl+C: cancel"))
 	return s.String()
 }
 
+// renderStageProgress draws a segmented bar across the stages the running
+// backend has announced so far (via execWriter.Stage), marking each as
+// done, in progress, or pending. Unlike the fixed 3-stage cookiecutter
+// pipeline, backends can report any number of named stages.
+func (m model) renderStageProgress() string {
+	var segments []string
+	for _, stage := range m.stageOrder {
+		label := fmt.Sprintf(" %s ", stage)
+		switch {
+		case m.stagesDone[stage]:
+			segments = append(segments, successStyle.Render("["+label+"done]"))
+		case stage == m.currentStage:
+			segments = append(segments, focusedStyle.Render("["+label+"...]"))
+		default:
+			segments = append(segments, subtitleStyle.Render("["+label+"]"))
+		}
+	}
+
+	return strings.Join(segments, " -> ")
+}
 func (m model) renderDone() string {
 	var s strings.Builder
 
@@ -440,7 +792,7 @@ func (m model) renderDone() string {
 		s.WriteString("\n\n")
 		s.WriteString(fmt.Sprintf("Your project '%s' has been created successfully!\n\n", m.projectName))
 
-		projectSlug := strings.ToLower(strings.ReplaceAll(m.projectName, " ", "-"))
+		projectSlug := projectSlugFor(configFromModel(m))
 
 		s.WriteString(focusedStyle.Render("Next steps:"))
 		s.WriteString("\n\n")
@@ -460,6 +812,20 @@ func (m model) renderDone() string {
 			s.WriteString("  pip install -r requirements.txt\n")
 			s.WriteString(fmt.Sprintf("  uvicorn app.main:app --reload --port %s\n", m.backendPort))
 		}
+
+		if len(m.hookResults) > 0 {
+			s.WriteString("\n")
+			s.WriteString(focusedStyle.Render("Post-generation hooks:"))
+			s.WriteString("\n\n")
+			for _, hook := range m.hookResults {
+				if hook.Err != nil {
+					s.WriteString(errorStyle.Render(fmt.Sprintf("  [failed] %s: %v", hook.Name, hook.Err)))
+				} else {
+					s.WriteString(successStyle.Render(fmt.Sprintf("  [ok] %s", hook.Name)))
+				}
+				s.WriteString("\n")
+			}
+		}
 	}
 
 	s.WriteString("\n")
@@ -467,65 +833,28 @@ func (m model) renderDone() string {
 	return s.String()
 }
 
-func (m model) executeSetup() tea.Cmd {
-	return func() tea.Msg {
-		// Prepare cookiecutter variables
-		projectSlug := strings.ToLower(strings.ReplaceAll(m.projectName, " ", "-"))
-
-		// Create virtual environment
-		venvCmd := exec.Command("python3", "-m", "venv", ".venv")
-		if output, err := venvCmd.CombinedOutput(); err != nil {
-			return executionCompleteMsg{
-				output: string(output),
-				err:    fmt.Errorf("failed to create venv: %w", err),
-			}
-		}
-
-		// Install cookiecutter
-		pipCmd := exec.Command(".venv/bin/pip", "install", "cookiecutter")
-		if output, err := pipCmd.CombinedOutput(); err != nil {
-			return executionCompleteMsg{
-				output: string(output),
-				err:    fmt.Errorf("failed to install cookiecutter: %w", err),
-			}
-		}
+// runSetup resolves cfg's backend and runs it to completion, returning its
+// combined output, plus the outcome of any post-generation hooks. It is
+// used by the non-interactive path; the interactive
+// TUI instead streams output live via startStreamingSetup.
+func runSetup(cfg Config) (string, []hookResult, error) {
+	backend, err := backendFor(cfg.Backend)
+	if err != nil {
+		return "", nil, err
+	}
 
-		// Get the template from GitHub
-		templateGithubURL := "https://github.com/thalestmm/fastapi-cookiecutter-template.git"
-
-		// Build cookiecutter command with all variables passed as arguments
-		cookiecutterCmd := exec.Command(
-			".venv/bin/cookiecutter",
-			templateGithubURL,
-			"--no-input",
-			"--overwrite-if-exists",
-			"project_name="+m.projectName,
-			"project_slug="+projectSlug,
-			"project_description="+m.projectDescription,
-			"author="+m.author,
-			"email="+m.email,
-			"python_version="+m.pythonVersion,
-			"backend_port="+m.backendPort,
-			"use_postgres="+yesNo(m.usePostgres),
-			"use_supabase="+yesNo(m.useSupabase),
-			"ai_project="+yesNo(m.aiProject),
-			"use_celery="+yesNo(m.useCelery),
-			"use_docker="+yesNo(m.useDocker),
-		)
-
-		output, err := cookiecutterCmd.CombinedOutput()
-		if err != nil {
-			return executionCompleteMsg{
-				output: string(output),
-				err:    fmt.Errorf("failed to run cookiecutter: %w", err),
-			}
-		}
+	ctx := context.Background()
+	if err := backend.Available(ctx); err != nil {
+		return "", nil, fmt.Errorf("%s backend unavailable: %w", backend.Name(), err)
+	}
 
-		return executionCompleteMsg{
-			output: string(output),
-			err:    nil,
-		}
+	var out bytes.Buffer
+	if err := backend.Generate(ctx, cfg, &out); err != nil {
+		return out.String(), nil, err
 	}
+
+	hooks := runPostGenHooks(ctx, cfg, &out)
+	return out.String(), hooks, nil
 }
 
 func yesNo(b bool) string {
@@ -536,7 +865,17 @@ func yesNo(b bool) string {
 }
 
 func main() {
-	p := tea.NewProgram(initialModel())
+	opts := parseFlags(os.Args[1:])
+
+	if opts.nonInteractive {
+		if err := runNonInteractive(opts); err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	p := tea.NewProgram(initialModel(opts.profile))
 	if _, err := p.Run(); err != nil {
 		fmt.Println("Error running program:", err)
 		os.Exit(1)
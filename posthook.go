@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// hookResult records the outcome of a single post-generation hook, so
+// renderDone can show each step's own success/failure rather than a single
+// pass/fail for the whole run.
+type hookResult struct {
+	Name string
+	Err  error
+}
+
+// runPostGenHooks runs the optional steps after a backend has successfully
+// generated the project: git init plus an initial commit on main, installing
+// backend dependencies, and (if Docker was selected) a docker compose build.
+// Each hook is reported to out as its own stage; a hook failing doesn't stop
+// the remaining hooks, since e.g. a missing `docker` binary shouldn't hide
+// whether git init succeeded.
+func runPostGenHooks(ctx context.Context, cfg Config, out io.Writer) []hookResult {
+	dest := projectSlugFor(cfg)
+	var results []hookResult
+
+	if cfg.GitInit {
+		reportStage(out, "Git init & commit")
+		results = append(results, hookResult{
+			Name: "Git init & commit",
+			Err:  runGitInitHook(ctx, dest, out),
+		})
+	}
+
+	if cfg.InstallDeps {
+		reportStage(out, "Installing backend dependencies")
+		results = append(results, hookResult{
+			Name: "Install backend dependencies",
+			Err:  runInstallHook(ctx, dest, out),
+		})
+
+		if cfg.UseDocker {
+			reportStage(out, "Building Docker images")
+			results = append(results, hookResult{
+				Name: "Build Docker images",
+				Err:  runDockerBuildHook(ctx, dest, out),
+			})
+		}
+	}
+
+	return results
+}
+
+// runGitInitHook initializes a git repository in dest, stages everything,
+// makes an initial commit, and names the resulting branch "main" regardless
+// of the local git's init.defaultBranch setting.
+func runGitInitHook(ctx context.Context, dest string, out io.Writer) error {
+	hookCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	defer cancel()
+
+	if err := runCommandStreamed(hookCtx, out, dest, "git", "init"); err != nil {
+		return fmt.Errorf("git init failed: %w", err)
+	}
+	if err := runCommandStreamed(hookCtx, out, dest, "git", "add", "-A"); err != nil {
+		return fmt.Errorf("git add failed: %w", err)
+	}
+	if err := runCommandStreamed(hookCtx, out, dest, "git", "commit", "-m", "chore: initial commit from template"); err != nil {
+		return fmt.Errorf("git commit failed: %w", err)
+	}
+	if err := runCommandStreamed(hookCtx, out, dest, "git", "branch", "-M", "main"); err != nil {
+		return fmt.Errorf("git branch rename failed: %w", err)
+	}
+	return nil
+}
+
+// runInstallHook installs the generated backend's dependencies: uv sync if
+// the backend has a pyproject.toml (uv manages its own venv), otherwise a
+// throwaway .venv plus pip install -r requirements.txt, mirroring the venv
+// step the cookiecutter/copier backends already use. Installing into the
+// system Python isn't an option: PEP 668 "externally-managed-environment"
+// Pythons (Debian 12+/Ubuntu 23.04+/Fedora) refuse bare pip installs.
+func runInstallHook(ctx context.Context, dest string, out io.Writer) error {
+	backendDir := filepath.Join(dest, "backend")
+
+	hookCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	if _, err := os.Stat(filepath.Join(backendDir, "pyproject.toml")); err == nil {
+		if err := runCommandStreamed(hookCtx, out, backendDir, "uv", "sync"); err != nil {
+			return fmt.Errorf("uv sync failed: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := os.Stat(filepath.Join(backendDir, "requirements.txt")); err == nil {
+		if err := runCommandStreamed(hookCtx, out, backendDir, "python3", "-m", "venv", ".venv"); err != nil {
+			return fmt.Errorf("failed to create venv: %w", err)
+		}
+		if err := runCommandStreamed(hookCtx, out, backendDir, ".venv/bin/pip", "install", "-r", "requirements.txt"); err != nil {
+			return fmt.Errorf("pip install failed: %w", err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("no pyproject.toml or requirements.txt found in %s", backendDir)
+}
+
+// runDockerBuildHook runs "docker compose build" from the project root,
+// where the generated docker-compose.yml lives.
+func runDockerBuildHook(ctx context.Context, dest string, out io.Writer) error {
+	hookCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+
+	if err := runCommandStreamed(hookCtx, out, dest, "docker", "compose", "build"); err != nil {
+		return fmt.Errorf("docker compose build failed: %w", err)
+	}
+	return nil
+}
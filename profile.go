@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// lastUsedProfileName is the profile automatically written after every
+// successful generation, so the next run (TUI or --profile last-used) can
+// default to whatever was just used.
+const lastUsedProfileName = "last-used"
+
+// startFreshChoice is the sentinel entry in the TUI's profile list meaning
+// "don't load a profile, start from the built-in defaults".
+const startFreshChoice = "Start fresh"
+
+// profilesDir returns the directory saved answer profiles live in,
+// creating it if it doesn't already exist.
+func profilesDir() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve config directory: %w", err)
+	}
+
+	dir := filepath.Join(configDir, "fastapi-gen", "profiles")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create profiles directory: %w", err)
+	}
+	return dir, nil
+}
+
+func profilePath(name string) (string, error) {
+	dir, err := profilesDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".yaml"), nil
+}
+
+// saveProfile writes cfg to the named profile file, overwriting it if one
+// already exists.
+func saveProfile(name string, cfg Config) error {
+	path, err := profilePath(name)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to encode profile %q: %w", name, err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write profile %q: %w", name, err)
+	}
+	return nil
+}
+
+// saveCompletedProfile saves cfg as both "last-used" and a profile named
+// after the generated project's slug, so it can be reloaded with
+// --profile later or picked up automatically next run.
+func saveCompletedProfile(cfg Config) error {
+	if err := saveProfile(lastUsedProfileName, cfg); err != nil {
+		return err
+	}
+	if slug := projectSlugFor(cfg); slug != "" {
+		return saveProfile(slug, cfg)
+	}
+	return nil
+}
+
+// profileExists reports whether a profile with the given name has been
+// saved.
+func profileExists(name string) bool {
+	path, err := profilePath(name)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// listProfileNames returns the names of saved profiles other than
+// "last-used", sorted alphabetically.
+func listProfileNames() ([]string, error) {
+	dir, err := profilesDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles directory: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), ".yaml")
+		if name == lastUsedProfileName {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+// buildProfileChoices lists the profiles the TUI's load screen should offer:
+// "last-used" first if it exists, then every other saved profile, then the
+// "start fresh" sentinel.
+func buildProfileChoices() []string {
+	var choices []string
+
+	if profileExists(lastUsedProfileName) {
+		choices = append(choices, lastUsedProfileName)
+	}
+
+	if names, err := listProfileNames(); err == nil {
+		choices = append(choices, names...)
+	}
+
+	return append(choices, startFreshChoice)
+}
+
+// knownConfigKeys are the YAML keys Config understands. loadProfile warns
+// about any others so a stale or hand-edited profile doesn't fail silently.
+var knownConfigKeys = map[string]bool{
+	"projectName":        true,
+	"projectDescription": true,
+	"author":             true,
+	"email":              true,
+	"pythonVersion":      true,
+	"backendPort":        true,
+	"backend":            true,
+	"useDocker":          true,
+	"usePostgres":        true,
+	"useSupabase":        true,
+	"aiProject":          true,
+	"useCelery":          true,
+	"gitInit":            true,
+	"installDeps":        true,
+}
+
+// supportedPythonVersions are the versions this template is tested against.
+// loadProfile warns (rather than errors) when a profile names anything else.
+var supportedPythonVersions = map[string]bool{
+	"3.10": true,
+	"3.11": true,
+	"3.12": true,
+	"3.13": true,
+	"3.14": true,
+}
+
+// loadProfile reads the named profile and returns its Config along with any
+// validation warnings: unknown keys (likely a stale or hand-edited file) and
+// python versions this template isn't known to support.
+func loadProfile(name string) (Config, []string, error) {
+	cfg := defaultConfig()
+
+	path, err := profilePath(name)
+	if err != nil {
+		return cfg, nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, nil, fmt.Errorf("failed to read profile %q: %w", name, err)
+	}
+
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, nil, fmt.Errorf("failed to parse profile %q: %w", name, err)
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return cfg, nil, fmt.Errorf("failed to parse profile %q: %w", name, err)
+	}
+
+	var warnings []string
+	for key := range raw {
+		if !knownConfigKeys[key] {
+			warnings = append(warnings, fmt.Sprintf("unknown field %q in profile %q (ignored)", key, name))
+		}
+	}
+	sort.Strings(warnings)
+
+	if cfg.PythonVersion != "" && !supportedPythonVersions[cfg.PythonVersion] {
+		warnings = append(warnings, fmt.Sprintf("python version %q from profile %q isn't one this template is tested against", cfg.PythonVersion, name))
+	}
+
+	return cfg, warnings, nil
+}
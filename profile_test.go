@@ -0,0 +1,85 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// withProfilesDir points profilesDir() at a fresh temp directory for the
+// duration of the test, so profile tests never touch the real
+// ~/.config/fastapi-gen.
+func withProfilesDir(t *testing.T) string {
+	t.Helper()
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	dir, err := profilesDir()
+	if err != nil {
+		t.Fatalf("profilesDir() error = %v", err)
+	}
+	return dir
+}
+
+func writeProfile(t *testing.T, dir, name, contents string) {
+	t.Helper()
+	path := filepath.Join(dir, name+".yaml")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadProfile_NoWarningsForKnownFields(t *testing.T) {
+	dir := withProfilesDir(t)
+	writeProfile(t, dir, "clean", "projectName: Clean\npythonVersion: \"3.14\"\n")
+
+	cfg, warnings, err := loadProfile("clean")
+	if err != nil {
+		t.Fatalf("loadProfile() error = %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("warnings = %v, want none", warnings)
+	}
+	if cfg.ProjectName != "Clean" {
+		t.Errorf("ProjectName = %q, want %q", cfg.ProjectName, "Clean")
+	}
+}
+
+func TestLoadProfile_WarnsOnUnknownField(t *testing.T) {
+	dir := withProfilesDir(t)
+	writeProfile(t, dir, "stale", "projectName: Stale\nnoSuchField: true\n")
+
+	_, warnings, err := loadProfile("stale")
+	if err != nil {
+		t.Fatalf("loadProfile() error = %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("warnings = %v, want exactly one", warnings)
+	}
+	if want := `unknown field "noSuchField" in profile "stale" (ignored)`; warnings[0] != want {
+		t.Errorf("warnings[0] = %q, want %q", warnings[0], want)
+	}
+}
+
+func TestLoadProfile_WarnsOnUnsupportedPythonVersion(t *testing.T) {
+	dir := withProfilesDir(t)
+	writeProfile(t, dir, "old-python", "projectName: OldPython\npythonVersion: \"2.7\"\n")
+
+	_, warnings, err := loadProfile("old-python")
+	if err != nil {
+		t.Fatalf("loadProfile() error = %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("warnings = %v, want exactly one", warnings)
+	}
+	if want := `python version "2.7" from profile "old-python" isn't one this template is tested against`; warnings[0] != want {
+		t.Errorf("warnings[0] = %q, want %q", warnings[0], want)
+	}
+}
+
+func TestLoadProfile_MissingFile(t *testing.T) {
+	withProfilesDir(t)
+
+	if _, _, err := loadProfile("does-not-exist"); err == nil {
+		t.Error("loadProfile() error = nil, want an error for a missing profile")
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// logLineMsg carries a single line of stdout/stderr from the running
+// command back into the Update loop.
+type logLineMsg struct {
+	line string
+}
+
+// stageChangedMsg announces that the backend moved on to a new named stage,
+// used to drive the segmented progress bar. Backends report their own
+// stages via execWriter.Stage, so the number and names of stages vary by
+// backend.
+type stageChangedMsg struct {
+	name string
+}
+
+// execDoneMsg announces the pipeline finished, successfully or not. hooks
+// carries the outcome of any post-generation hooks that ran, in order.
+type execDoneMsg struct {
+	err   error
+	hooks []hookResult
+}
+
+// execWriter adapts the line-oriented logLineMsg/stageChangedMsg protocol
+// to a plain io.Writer, so GeneratorBackend implementations only ever need
+// to know about io.Writer and the optional stage-reporting interface.
+type execWriter struct {
+	sub chan tea.Msg
+	buf []byte
+}
+
+func (w *execWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := string(w.buf[:i])
+		w.buf = w.buf[i+1:]
+		w.sub <- logLineMsg{line: line}
+	}
+	return len(p), nil
+}
+
+// Stage lets a GeneratorBackend announce progress; see reportStage.
+func (w *execWriter) Stage(name string) {
+	w.sub <- stageChangedMsg{name: name}
+}
+
+// reportStage announces a new stage on out if out supports it (the TUI's
+// execWriter does; a plain io.Writer used by the non-interactive path does
+// not, and is simply skipped).
+func reportStage(out io.Writer, name string) {
+	if sr, ok := out.(interface{ Stage(string) }); ok {
+		sr.Stage(name)
+	}
+}
+
+// startStreamingSetup launches cfg's selected GeneratorBackend in a
+// background goroutine and returns a channel of tea.Msg values plus a
+// cancel func the Update loop can use to abort the in-flight command.
+func startStreamingSetup(cfg Config) (chan tea.Msg, context.CancelFunc) {
+	sub := make(chan tea.Msg)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go runSetupStreaming(ctx, cfg, sub)
+
+	return sub, cancel
+}
+
+// listenForExecMsg blocks for the next message from the streaming setup
+// goroutine. Update re-issues this command after every message so the
+// channel is drained one message at a time.
+func listenForExecMsg(sub chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-sub
+	}
+}
+
+// runSetupStreaming resolves cfg's backend and runs it to completion,
+// streaming its output and stage changes to sub.
+func runSetupStreaming(ctx context.Context, cfg Config, sub chan tea.Msg) {
+	out := &execWriter{sub: sub}
+
+	backend, err := backendFor(cfg.Backend)
+	if err != nil {
+		sub <- execDoneMsg{err: err}
+		return
+	}
+
+	if err := backend.Available(ctx); err != nil {
+		sub <- execDoneMsg{err: fmt.Errorf("%s backend unavailable: %w", backend.Name(), err)}
+		return
+	}
+
+	if err := backend.Generate(ctx, cfg, out); err != nil {
+		sub <- execDoneMsg{err: err}
+		return
+	}
+
+	hooks := runPostGenHooks(ctx, cfg, out)
+	sub <- execDoneMsg{hooks: hooks}
+}
+
+// runCommandStreamed runs a single command to completion in dir (the
+// process's own working directory if dir is ""), forwarding every line of
+// stdout and stderr to out as it's produced.
+func runCommandStreamed(ctx context.Context, out io.Writer, dir, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go scanLines(stdout, out, &mu, &wg)
+	go scanLines(stderr, out, &mu, &wg)
+	wg.Wait()
+
+	return cmd.Wait()
+}
+
+func scanLines(r io.Reader, out io.Writer, mu *sync.Mutex, wg *sync.WaitGroup) {
+	defer wg.Done()
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		mu.Lock()
+		fmt.Fprintln(out, scanner.Text())
+		mu.Unlock()
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+)
+
+const (
+	minBackendPort = 1024
+	maxBackendPort = 65535
+)
+
+var (
+	emailPattern         = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+	pythonVersionPattern = regexp.MustCompile(`^3\.\d{1,2}$`)
+	projectNamePattern   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9 _-]*$`)
+)
+
+// validateStepInput checks the raw text input value for a given text-input
+// step before it's accepted into the model. Steps with no specific format
+// (just required-non-empty, already checked by the caller) return nil.
+func validateStepInput(s step, value string) error {
+	switch s {
+	case stepProjectName:
+		if !projectNamePattern.MatchString(value) {
+			return fmt.Errorf("project name must start with a letter or digit, and contain only letters, digits, spaces, - or _ (so it maps cleanly to a project slug)")
+		}
+	case stepEmail:
+		if !emailPattern.MatchString(value) {
+			return fmt.Errorf("%q doesn't look like a valid email address", value)
+		}
+	case stepPythonVersion:
+		if !pythonVersionPattern.MatchString(value) {
+			return fmt.Errorf("python version must look like 3.12 or 3.14")
+		}
+	case stepBackendPort:
+		port, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("backend port must be a number")
+		}
+		if port < minBackendPort || port > maxBackendPort {
+			return fmt.Errorf("backend port must be between %d and %d", minBackendPort, maxBackendPort)
+		}
+	}
+	return nil
+}
+
+// checkPortAvailable is a preflight check run once a port passes validation:
+// it tries to open a TCP listener on the port to detect whether something
+// else is already using it. An occupied port only produces a warning, not a
+// blocking error, since the port may free up by the time generation runs.
+func checkPortAvailable(port string) string {
+	ln, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return fmt.Sprintf("port %s may already be in use: %v", port, err)
+	}
+	ln.Close()
+	return ""
+}
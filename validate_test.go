@@ -0,0 +1,45 @@
+package main
+
+import "testing"
+
+func TestValidateStepInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		step    step
+		value   string
+		wantErr bool
+	}{
+		{"project name valid", stepProjectName, "My Project", false},
+		{"project name starts with space", stepProjectName, " leading-space", true},
+		{"project name starts with dash", stepProjectName, "-leading-dash", true},
+		{"project name single char", stepProjectName, "a", false},
+
+		{"email valid", stepEmail, "dev@example.com", false},
+		{"email missing at", stepEmail, "devexample.com", true},
+		{"email missing domain dot", stepEmail, "dev@examplecom", true},
+		{"email with spaces", stepEmail, "dev @example.com", true},
+
+		{"python version valid", stepPythonVersion, "3.12", false},
+		{"python version single digit minor", stepPythonVersion, "3.9", false},
+		{"python version major 2", stepPythonVersion, "2.7", true},
+		{"python version not numeric", stepPythonVersion, "3.x", true},
+
+		{"backend port valid", stepBackendPort, "8000", false},
+		{"backend port not a number", stepBackendPort, "abc", true},
+		{"backend port below range", stepBackendPort, "80", true},
+		{"backend port above range", stepBackendPort, "70000", true},
+		{"backend port min boundary", stepBackendPort, "1024", false},
+		{"backend port max boundary", stepBackendPort, "65535", false},
+
+		{"step with no format check", stepAuthor, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateStepInput(tt.step, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateStepInput(%v, %q) error = %v, wantErr %v", tt.step, tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}